@@ -0,0 +1,64 @@
+// Package collector abstracts where GPU telemetry values come from, so the
+// exporter can be pointed at either the synthetic mock generator or a real
+// NVML-backed device query without changing anything downstream of the
+// Prometheus gauges.
+package collector
+
+// DeviceMetrics holds one device's worth of telemetry, in the same units
+// DCGM/NVML report them in (MiB for memory, MHz for clocks, W for power,
+// C for temperature, % for utilization).
+type DeviceMetrics struct {
+	Index     int
+	UUID      string
+	ModelName string
+
+	MemoryTotal float64
+	MemoryUsed  float64
+	MemoryFree  float64
+	BAR1Total   float64
+	BAR1Used    float64
+
+	SMClock     float64
+	MemClock    float64
+	VideoClock  float64
+	AppSMClock  float64
+	AppMemClock float64
+
+	GPUTemp    float64
+	MemoryTemp float64
+	PowerUsage float64
+
+	GPUUtil     float64
+	MemCopyUtil float64
+	EncUtil     float64
+	DecUtil     float64
+
+	ClockThrottleReasons float64
+
+	RetiredSBE     float64
+	RetiredDBE     float64
+	RetiredPending float64
+	XIDErrors      float64
+
+	NVLinkBandwidthTotal float64
+	NVLinkRXBytes        float64
+	NVLinkTXBytes        float64
+	PCIeRXBytes          float64
+	PCIeTXBytes          float64
+	DRAMActive           float64
+}
+
+// Snapshot is one full collection pass across every device on the node.
+type Snapshot struct {
+	Devices []DeviceMetrics
+}
+
+// Source produces a Snapshot on demand. Implementations may be backed by
+// real hardware (NVML) or by a synthetic generator (mock).
+type Source interface {
+	// Name identifies the source for logging, e.g. "nvml" or "mock".
+	Name() string
+	// Collect gathers one Snapshot. An error indicates the source could not
+	// talk to its backend for this pass.
+	Collect() (*Snapshot, error)
+}
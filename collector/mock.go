@@ -0,0 +1,75 @@
+package collector
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// ModelName and MemoryTotalMiB are the identity of the synthetic PPU-ZW810E
+// device the mock source and its scenario drivers report.
+const (
+	ModelName      = "PPU-ZW810E"
+	MemoryTotalMiB = 98304.0
+)
+
+// knownUUIDs are realistic-looking GPU UUIDs handed out to the first devices
+// so demo output stays stable across runs; anything beyond this list gets a
+// randomly generated one.
+var knownUUIDs = []string{
+	"GPU-019e0219-0331-020a-0000-0000608e8e2e",
+	"GPU-019e0225-c611-0110-0000-0000c0663c0e",
+	"GPU-019e120d-8850-032c-0000-0000406a3958",
+	"GPU-019e120d-8930-0516-0000-000040b6030b",
+	"GPU-019e1211-40c0-0624-0000-000060f3f056",
+	"GPU-019e1211-4120-0524-0000-0000c09f426b",
+	"GPU-019e1215-0231-0014-0000-000060512b5e",
+	"GPU-019e1215-0241-0820-0000-0000a0087936",
+	"GPU-019e1215-0281-0210-0000-0000a0d60a51",
+	"GPU-019e1215-c280-0416-0000-0000407aa063",
+	"GPU-019e1215-c2a0-0226-0000-0000c0c6fa0a",
+	"GPU-019e4201-0591-0330-0000-000060416e2b",
+	"GPU-019e4201-8920-0430-0000-0000605abd70",
+	"GPU-019e4201-8920-0614-0000-0000603e9c39",
+	"GPU-019e4201-8930-0014-0000-000020029626",
+	"GPU-019ec20c-49c2-0224-0000-0000e02b8d24",
+}
+
+// DeviceUUID returns a realistic GPU UUID for a device index. Scenario
+// drivers use this too, so the identity of a device stays the same no
+// matter which profile is driving its values.
+func DeviceUUID(index int) string {
+	if index < len(knownUUIDs) {
+		return knownUUIDs[index]
+	}
+	return fmt.Sprintf("GPU-019e%04d-%04d-%04d-0000-0000%08x", index, rand.Intn(10000), rand.Intn(10000), rand.Intn(0xFFFFFFFF))
+}
+
+// Generator produces one device's metrics for a given index on one
+// collection tick. The scenario package implements this to drive the mock
+// source with coherent, reproducible workload shapes.
+type Generator interface {
+	Generate(index int) DeviceMetrics
+}
+
+// MockSource is the synthetic generator: no real hardware is queried, a
+// Generator supplies each device's values for the tick.
+type MockSource struct {
+	GPUCount  int
+	Generator Generator
+}
+
+// NewMockSource builds a MockSource that reports gpuCount devices driven by
+// gen.
+func NewMockSource(gpuCount int, gen Generator) *MockSource {
+	return &MockSource{GPUCount: gpuCount, Generator: gen}
+}
+
+func (s *MockSource) Name() string { return "mock" }
+
+func (s *MockSource) Collect() (*Snapshot, error) {
+	snap := &Snapshot{Devices: make([]DeviceMetrics, s.GPUCount)}
+	for i := range snap.Devices {
+		snap.Devices[i] = s.Generator.Generate(i)
+	}
+	return snap, nil
+}
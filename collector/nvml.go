@@ -0,0 +1,161 @@
+package collector
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+// NVMLSource reads live telemetry from the NVIDIA Management Library. It
+// mirrors most of the field set cc-metric-collector's nvidiaMetric.go pulls
+// from NVML to populate the same DCGM field IDs, so the exporter can double
+// as a real exporter for the PPU-ZW810E driver stack rather than only a
+// mock. MemoryTemp, XIDErrors, NVLinkBandwidthTotal/NVLinkRXBytes/
+// NVLinkTXBytes, and DRAMActive are not populated here: NVML's
+// TemperatureSensors enum has no memory-die sensor, and the rest need
+// either event-set registration or raw FieldValue decoding that this
+// source doesn't implement yet, so they're always reported as zero. See
+// collectDevice.
+type NVMLSource struct{}
+
+// NewNVMLSource initializes NVML. The caller must call Shutdown when done.
+// An error here means NVML is not usable on this host (no driver, no
+// devices, permission denied, ...) and the caller should fall back to the
+// mock source.
+func NewNVMLSource() (*NVMLSource, error) {
+	if ret := nvml.Init(); ret != nvml.SUCCESS {
+		return nil, fmt.Errorf("nvml init: %v", nvml.ErrorString(ret))
+	}
+	log.Printf("source=nvml: memory temperature, XID errors, NVLink bandwidth, and DRAM active cycles are not implemented in this source and will always read 0")
+	return &NVMLSource{}, nil
+}
+
+// Shutdown releases the underlying NVML handle.
+func (s *NVMLSource) Shutdown() {
+	nvml.Shutdown()
+}
+
+func (s *NVMLSource) Name() string { return "nvml" }
+
+func (s *NVMLSource) Collect() (*Snapshot, error) {
+	count, ret := nvml.DeviceGetCount()
+	if ret != nvml.SUCCESS {
+		return nil, fmt.Errorf("nvml device count: %v", nvml.ErrorString(ret))
+	}
+
+	snap := &Snapshot{Devices: make([]DeviceMetrics, 0, count)}
+	for i := 0; i < count; i++ {
+		dev, ret := nvml.DeviceGetHandleByIndex(i)
+		if ret != nvml.SUCCESS {
+			return nil, fmt.Errorf("nvml device handle %d: %v", i, nvml.ErrorString(ret))
+		}
+
+		m, err := collectDevice(dev, i)
+		if err != nil {
+			return nil, err
+		}
+		snap.Devices = append(snap.Devices, m)
+	}
+
+	return snap, nil
+}
+
+func collectDevice(dev nvml.Device, index int) (DeviceMetrics, error) {
+	uuid, ret := dev.GetUUID()
+	if ret != nvml.SUCCESS {
+		return DeviceMetrics{}, fmt.Errorf("nvml uuid %d: %v", index, nvml.ErrorString(ret))
+	}
+	model, ret := dev.GetName()
+	if ret != nvml.SUCCESS {
+		return DeviceMetrics{}, fmt.Errorf("nvml name %d: %v", index, nvml.ErrorString(ret))
+	}
+
+	memInfo, ret := dev.GetMemoryInfo()
+	if ret != nvml.SUCCESS {
+		return DeviceMetrics{}, fmt.Errorf("nvml memory %d: %v", index, nvml.ErrorString(ret))
+	}
+	bar1, ret := dev.GetBAR1MemoryInfo()
+	if ret != nvml.SUCCESS {
+		return DeviceMetrics{}, fmt.Errorf("nvml bar1 %d: %v", index, nvml.ErrorString(ret))
+	}
+
+	util, ret := dev.GetUtilizationRates()
+	if ret != nvml.SUCCESS {
+		return DeviceMetrics{}, fmt.Errorf("nvml utilization %d: %v", index, nvml.ErrorString(ret))
+	}
+
+	smClock, _ := dev.GetClockInfo(nvml.CLOCK_SM)
+	memClock, _ := dev.GetClockInfo(nvml.CLOCK_MEM)
+	videoClock, _ := dev.GetClockInfo(nvml.CLOCK_VIDEO)
+	appSMClock, _ := dev.GetApplicationsClock(nvml.CLOCK_SM)
+	appMemClock, _ := dev.GetApplicationsClock(nvml.CLOCK_MEM)
+
+	gpuTemp, ret := dev.GetTemperature(nvml.TEMPERATURE_GPU)
+	if ret != nvml.SUCCESS {
+		return DeviceMetrics{}, fmt.Errorf("nvml temperature %d: %v", index, nvml.ErrorString(ret))
+	}
+
+	powerMilliwatts, ret := dev.GetPowerUsage()
+	if ret != nvml.SUCCESS {
+		return DeviceMetrics{}, fmt.Errorf("nvml power %d: %v", index, nvml.ErrorString(ret))
+	}
+
+	throttleReasons, _ := dev.GetCurrentClocksThrottleReasons()
+
+	sbePages, _ := dev.GetRetiredPages(nvml.PAGE_RETIREMENT_CAUSE_MULTIPLE_SINGLE_BIT_ECC_ERRORS)
+	dbePages, _ := dev.GetRetiredPages(nvml.PAGE_RETIREMENT_CAUSE_DOUBLE_BIT_ECC_ERROR)
+	pendingPages, _ := dev.GetRetiredPagesPendingStatus()
+
+	rxBytes, _ := dev.GetPcieThroughput(nvml.PCIE_UTIL_RX_BYTES)
+	txBytes, _ := dev.GetPcieThroughput(nvml.PCIE_UTIL_TX_BYTES)
+
+	encUtil, _, _ := dev.GetEncoderUtilization()
+	decUtil, _, _ := dev.GetDecoderUtilization()
+
+	return DeviceMetrics{
+		Index:     index,
+		UUID:      uuid,
+		ModelName: model,
+
+		MemoryTotal: float64(memInfo.Total) / (1 << 20),
+		MemoryUsed:  float64(memInfo.Used) / (1 << 20),
+		MemoryFree:  float64(memInfo.Free) / (1 << 20),
+		BAR1Total:   float64(bar1.Bar1Total) / (1 << 20),
+		BAR1Used:    float64(bar1.Bar1Used) / (1 << 20),
+
+		SMClock:     float64(smClock),
+		MemClock:    float64(memClock),
+		VideoClock:  float64(videoClock),
+		AppSMClock:  float64(appSMClock),
+		AppMemClock: float64(appMemClock),
+
+		GPUTemp:    float64(gpuTemp),
+		PowerUsage: float64(powerMilliwatts) / 1000,
+
+		GPUUtil:     float64(util.Gpu),
+		MemCopyUtil: float64(util.Memory),
+		EncUtil:     float64(encUtil),
+		DecUtil:     float64(decUtil),
+
+		ClockThrottleReasons: float64(throttleReasons),
+
+		RetiredSBE:     float64(len(sbePages)),
+		RetiredDBE:     float64(len(dbePages)),
+		RetiredPending: boolToFloat(pendingPages == nvml.FEATURE_ENABLED),
+
+		PCIeRXBytes: float64(rxBytes) * 1024,
+		PCIeTXBytes: float64(txBytes) * 1024,
+
+		// MemoryTemp, XIDErrors, NVLinkBandwidthTotal, NVLinkRXBytes,
+		// NVLinkTXBytes, and DRAMActive are left at zero: see the
+		// NVMLSource doc comment.
+	}, nil
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,22 @@
+package collector
+
+import "log"
+
+// New resolves the --source flag to a concrete Source. "nvml" is attempted
+// first; if NVML can't be initialized (library missing, no devices,
+// permission denied, ...) it logs why and falls back to the mock generator
+// so the exporter still comes up on a box without real PPU hardware. gen
+// drives the mock generator whenever it's in play, whether chosen directly
+// or fallen back to.
+func New(source string, gpuCount int, gen Generator) Source {
+	if source != "nvml" {
+		return NewMockSource(gpuCount, gen)
+	}
+
+	nvmlSource, err := NewNVMLSource()
+	if err != nil {
+		log.Printf("source=nvml unavailable (%v), falling back to source=mock", err)
+		return NewMockSource(gpuCount, gen)
+	}
+	return nvmlSource
+}
@@ -11,12 +11,21 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"github.com/Wangmin362/ppu-exporter-mock/collector"
+	"github.com/Wangmin362/ppu-exporter-mock/mig"
+	"github.com/Wangmin362/ppu-exporter-mock/process"
+	"github.com/Wangmin362/ppu-exporter-mock/push"
+	"github.com/Wangmin362/ppu-exporter-mock/scenario"
 )
 
 const (
-	defaultNodeName = "ppu-worker-mock"
-	defaultPort     = 8080
-	defaultGPUCount = 16
+	defaultNodeName  = "ppu-worker-mock"
+	defaultPort      = 8080
+	defaultGPUCount  = 16
+	defaultSource    = "mock"
+	defaultAllocMode = "None"
+	defaultScenario  = "idle"
 )
 
 type Config struct {
@@ -26,14 +35,23 @@ type Config struct {
 	Port          int
 	GPUCount      int
 	DriverVersion string
+	Source        string
+	AllocateMode  string
+	Scenario      string
+	MIGConfig     string
 }
 
 type PPUExporter struct {
-	config *Config
+	config        *Config
+	source        collector.Source
+	processSource process.Source
+	processPolicy process.Policy
+	migSlices     map[int][]mig.Slice
 
 	// DCGM Custom metrics
 	allocateModeGauge         prometheus.Gauge
 	devFBAllocatedGauge       *prometheus.GaugeVec
+	devFBPressureGauge        *prometheus.GaugeVec
 	devFBTotalGauge           *prometheus.GaugeVec
 	illegalProcessDecodeUtil  *prometheus.GaugeVec
 	illegalProcessEncodeUtil  *prometheus.GaugeVec
@@ -75,12 +93,28 @@ type PPUExporter struct {
 }
 
 func NewPPUExporter(config *Config) *PPUExporter {
-	deviceLabels := []string{"Hostname", "NodeName", "NodePoolId", "PodSource", "UUID", "device", "gpu", "modelName"}
-	customDeviceLabels := []string{"DriverVersion", "NodeName", "NodePoolId", "PodSource", "SupportDCGM", "UUID", "device", "gpu", "modelName"}
+	deviceLabels := []string{"GPU_I_ID", "GPU_I_PROFILE", "Hostname", "NodeName", "NodePoolId", "PodSource", "UUID", "device", "gpu", "modelName"}
+	customDeviceLabels := []string{"DriverVersion", "GPU_I_ID", "GPU_I_PROFILE", "NodeName", "NodePoolId", "PodSource", "SupportDCGM", "UUID", "device", "gpu", "modelName"}
 	illegalProcessLabels := []string{"AllocateMode", "ContainerName", "NamespaceName", "NodeName", "NodePoolId", "PodName", "PodSource", "ProcessId", "ProcessName", "ProcessType", "UUID", "device", "gpu", "modelName"}
 
+	metricsSource := collector.New(config.Source, config.GPUCount, scenario.New(config.Scenario))
+
+	migSlices, err := mig.ParseConfig(config.MIGConfig)
+	if err != nil {
+		log.Printf("ignoring --mig-config: %v", err)
+		migSlices = nil
+	}
+	if len(migSlices) > 0 && metricsSource.Name() != "mock" {
+		log.Printf("ignoring --mig-config: MIG slice modeling only supports source=mock (got source=%s); a real device's memory total isn't known to fit the configured slices", metricsSource.Name())
+		migSlices = nil
+	}
+
 	return &PPUExporter{
-		config: config,
+		config:        config,
+		source:        metricsSource,
+		processSource: process.New(metricsSource.Name()),
+		processPolicy: process.AllocateModePolicy{AllocateMode: config.AllocateMode},
+		migSlices:     migSlices,
 
 		// DCGM Custom metrics
 		allocateModeGauge: prometheus.NewGauge(prometheus.GaugeOpts{
@@ -91,6 +125,10 @@ func NewPPUExporter(config *Config) *PPUExporter {
 			Name: "DCGM_CUSTOM_DEV_FB_ALLOCATED",
 			Help: "Allocated framebuffer memory ratio(0~1) of device,it is a custom metric created by ack",
 		}, customDeviceLabels),
+		devFBPressureGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "DCGM_CUSTOM_DEV_FB_PRESSURE",
+			Help: "Memory pressure of device(used_memory/total_memory*100),useful as an alternative utilization signal when DCGM_FI_DEV_GPU_UTIL is unreliable",
+		}, customDeviceLabels),
 		devFBTotalGauge: prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "DCGM_CUSTOM_DEV_FB_TOTAL",
 			Help: "Total framebuffer memory of device(in MiB),it is a custom metric created by ack",
@@ -238,6 +276,7 @@ func (e *PPUExporter) Register(registry *prometheus.Registry) {
 	registry.MustRegister(
 		e.allocateModeGauge,
 		e.devFBAllocatedGauge,
+		e.devFBPressureGauge,
 		e.devFBTotalGauge,
 		e.illegalProcessDecodeUtil,
 		e.illegalProcessEncodeUtil,
@@ -275,170 +314,218 @@ func (e *PPUExporter) Register(registry *prometheus.Registry) {
 	)
 }
 
-func (e *PPUExporter) generateUUID(gpuID int) string {
-	// Generate realistic UUID based on GPU ID
-	prefixes := []string{
-		"GPU-019e0219-0331-020a-0000-0000608e8e2e",
-		"GPU-019e0225-c611-0110-0000-0000c0663c0e",
-		"GPU-019e120d-8850-032c-0000-0000406a3958",
-		"GPU-019e120d-8930-0516-0000-000040b6030b",
-		"GPU-019e1211-40c0-0624-0000-000060f3f056",
-		"GPU-019e1211-4120-0524-0000-0000c09f426b",
-		"GPU-019e1215-0231-0014-0000-000060512b5e",
-		"GPU-019e1215-0241-0820-0000-0000a0087936",
-		"GPU-019e1215-0281-0210-0000-0000a0d60a51",
-		"GPU-019e1215-c280-0416-0000-0000407aa063",
-		"GPU-019e1215-c2a0-0226-0000-0000c0c6fa0a",
-		"GPU-019e4201-0591-0330-0000-000060416e2b",
-		"GPU-019e4201-8920-0430-0000-0000605abd70",
-		"GPU-019e4201-8920-0614-0000-0000603e9c39",
-		"GPU-019e4201-8930-0014-0000-000020029626",
-		"GPU-019ec20c-49c2-0224-0000-0000e02b8d24",
+// setDeviceMetrics records one row of every device-scoped gauge/counter for
+// m. giID/giProfile are empty for a whole-GPU row and set to the MIG
+// instance's GPU_I_ID/GPU_I_PROFILE for a per-slice row; device/gpu stay the
+// parent GPU's, since a slice lives on the same physical device.
+func (e *PPUExporter) setDeviceMetrics(m collector.DeviceMetrics, uuid, giID, giProfile string) {
+	gpuID := strconv.Itoa(m.Index)
+	deviceName := fmt.Sprintf("nvidia%d", m.Index)
+
+	deviceLabels := prometheus.Labels{
+		"GPU_I_ID":      giID,
+		"GPU_I_PROFILE": giProfile,
+		"Hostname":      e.config.NodeName,
+		"NodeName":      e.config.NodeName,
+		"NodePoolId":    e.config.NodePoolId,
+		"PodSource":     e.config.PodSource,
+		"UUID":          uuid,
+		"device":        deviceName,
+		"gpu":           gpuID,
+		"modelName":     "",
 	}
-	if gpuID < len(prefixes) {
-		return prefixes[gpuID]
+
+	customDeviceLabels := prometheus.Labels{
+		"DriverVersion": e.config.DriverVersion,
+		"GPU_I_ID":      giID,
+		"GPU_I_PROFILE": giProfile,
+		"NodeName":      e.config.NodeName,
+		"NodePoolId":    e.config.NodePoolId,
+		"PodSource":     e.config.PodSource,
+		"SupportDCGM":   "Yes",
+		"UUID":          uuid,
+		"device":        deviceName,
+		"gpu":           gpuID,
+		"modelName":     m.ModelName,
 	}
-	return fmt.Sprintf("GPU-019e%04d-%04d-%04d-0000-0000%08x", gpuID, rand.Intn(10000), rand.Intn(10000), rand.Intn(0xFFFFFFFF))
+
+	// Custom metrics
+	e.devFBAllocatedGauge.With(customDeviceLabels).Set(0) // Typically 0 when not allocated
+	e.devFBPressureGauge.With(customDeviceLabels).Set(m.MemoryUsed / m.MemoryTotal * 100)
+	e.devFBTotalGauge.With(customDeviceLabels).Set(m.MemoryTotal)
+
+	// Standard device metrics
+	e.devAppMemClockGauge.With(deviceLabels).Set(m.AppMemClock)
+	e.devAppSMClockGauge.With(deviceLabels).Set(m.AppSMClock)
+	e.devBAR1TotalGauge.With(deviceLabels).Set(m.BAR1Total)
+	e.devBAR1UsedGauge.With(deviceLabels).Set(m.BAR1Used)
+
+	e.devClockThrottleReasons.With(deviceLabels).Set(m.ClockThrottleReasons)
+
+	e.devDecUtilGauge.With(deviceLabels).Set(m.DecUtil)
+	e.devEncUtilGauge.With(deviceLabels).Set(m.EncUtil)
+	e.devGPUUtilGauge.With(deviceLabels).Set(m.GPUUtil)
+
+	// Memory metrics
+	e.devFBFreeGauge.With(deviceLabels).Set(m.MemoryFree)
+	e.devFBUsedGauge.With(deviceLabels).Set(m.MemoryUsed)
+	e.devMemCopyUtilGauge.With(deviceLabels).Set(m.MemCopyUtil)
+
+	// Temperature metrics
+	e.devGPUTempGauge.With(deviceLabels).Set(m.GPUTemp)
+	e.devMemoryTempGauge.With(deviceLabels).Set(m.MemoryTemp)
+
+	// Clock frequencies
+	e.devMemClockGauge.With(deviceLabels).Set(m.MemClock)
+	e.devSMClockGauge.With(deviceLabels).Set(m.SMClock)
+	e.devVideoClockGauge.With(deviceLabels).Set(m.VideoClock)
+
+	// Power usage
+	e.devPowerUsageGauge.With(deviceLabels).Set(m.PowerUsage)
+
+	// Error counters
+	e.devRetiredDBE.With(deviceLabels).Add(m.RetiredDBE)
+	e.devRetiredPending.With(deviceLabels).Add(m.RetiredPending)
+	e.devRetiredSBE.With(deviceLabels).Add(m.RetiredSBE)
+	e.devXIDErrorsGauge.With(deviceLabels).Set(m.XIDErrors)
+
+	// Bandwidth counters
+	e.devNVLinkBandwidthTotal.With(deviceLabels).Add(m.NVLinkBandwidthTotal)
+
+	// Profiling metrics
+	e.profDRAMActiveGauge.With(deviceLabels).Set(m.DRAMActive)
+	e.profNVLinkRXBytes.With(deviceLabels).Add(m.NVLinkRXBytes)
+	e.profNVLinkTXBytes.With(deviceLabels).Add(m.NVLinkTXBytes)
+	e.profPCIeRXBytes.With(deviceLabels).Set(m.PCIeRXBytes)
+	e.profPCIeTXBytes.With(deviceLabels).Set(m.PCIeTXBytes)
+}
+
+// reduceForMIGSlices carves slices' memory out of the parent device's
+// totals so DCGM_FI_DEV_FB_* sums across a parent and its slices stay
+// consistent with the physical card.
+func reduceForMIGSlices(m collector.DeviceMetrics, slices []mig.Slice) collector.DeviceMetrics {
+	for _, s := range slices {
+		m.MemoryTotal -= s.MemoryMiB
+		if m.MemoryUsed > m.MemoryTotal {
+			m.MemoryUsed = m.MemoryTotal
+		}
+	}
+	m.MemoryFree = m.MemoryTotal - m.MemoryUsed
+	m.BAR1Total = m.MemoryTotal
+	m.BAR1Used = m.MemoryUsed
+	return m
+}
+
+// migSliceMetrics derives one MIG instance's reported metrics from its
+// already-reduced parent: memory/compute totals come from the slice's
+// profile, while temperature and clocks are inherited as-is from the
+// physical device they share.
+func migSliceMetrics(parent collector.DeviceMetrics, s mig.Slice) collector.DeviceMetrics {
+	slice := parent
+	slice.MemoryTotal = s.MemoryMiB
+	slice.MemoryUsed = 18.0 + rand.Float64()*100
+	if slice.MemoryUsed > slice.MemoryTotal {
+		slice.MemoryUsed = slice.MemoryTotal
+	}
+	slice.MemoryFree = slice.MemoryTotal - slice.MemoryUsed
+	slice.BAR1Total = slice.MemoryTotal
+	slice.BAR1Used = slice.MemoryUsed
+
+	slice.GPUUtil = parent.GPUUtil * s.SMFraction
+	slice.MemCopyUtil = parent.MemCopyUtil * s.SMFraction
+	slice.DRAMActive = parent.DRAMActive * s.SMFraction
+	slice.NVLinkRXBytes = parent.NVLinkRXBytes * s.SMFraction
+	slice.NVLinkTXBytes = parent.NVLinkTXBytes * s.SMFraction
+	slice.NVLinkBandwidthTotal = parent.NVLinkBandwidthTotal * s.SMFraction
+	slice.PCIeRXBytes = parent.PCIeRXBytes * s.SMFraction
+	slice.PCIeTXBytes = parent.PCIeTXBytes * s.SMFraction
+
+	// Temperature and clocks are properties of the physical device, shared
+	// unchanged by every instance carved out of it.
+	return slice
 }
 
 func (e *PPUExporter) UpdateMetrics() {
-	// Set allocate mode (typically 0 for none)
-	e.allocateModeGauge.Set(0)
+	snap, err := e.source.Collect()
+	if err != nil {
+		log.Printf("source=%s collect failed: %v", e.source.Name(), err)
+		return
+	}
+
+	// Set allocate mode, value in [None:0,Exclusive:1,Share:2]
+	e.allocateModeGauge.Set(allocateModeValue(e.config.AllocateMode))
 
 	// Set device count
-	e.devCountGauge.Set(float64(e.config.GPUCount))
-
-	for i := 0; i < e.config.GPUCount; i++ {
-		gpuID := strconv.Itoa(i)
-		deviceName := fmt.Sprintf("nvidia%d", i)
-		uuid := e.generateUUID(i)
-
-		deviceLabels := prometheus.Labels{
-			"Hostname":   e.config.NodeName,
-			"NodeName":   e.config.NodeName,
-			"NodePoolId": e.config.NodePoolId,
-			"PodSource":  e.config.PodSource,
-			"UUID":       uuid,
-			"device":     deviceName,
-			"gpu":        gpuID,
-			"modelName":  "",
-		}
+	e.devCountGauge.Set(float64(len(snap.Devices)))
 
-		customDeviceLabels := prometheus.Labels{
-			"DriverVersion": e.config.DriverVersion,
-			"NodeName":      e.config.NodeName,
-			"NodePoolId":    e.config.NodePoolId,
-			"PodSource":     e.config.PodSource,
-			"SupportDCGM":   "Yes",
-			"UUID":          uuid,
-			"device":        deviceName,
-			"gpu":           gpuID,
-			"modelName":     "PPU-ZW810E",
-		}
+	uuids := make([]string, len(snap.Devices))
+
+	for _, m := range snap.Devices {
+		uuids[m.Index] = m.UUID
 
-		// Memory metrics (98304 MiB total for PPU-ZW810E)
-		memoryTotal := 98304.0
-		memoryUsed := 18.0 + rand.Float64()*100 // Base usage plus random
-		if i == 0 || i == 14 {                  // Some GPUs have higher usage
-			memoryUsed = 500 + rand.Float64()*4000
+		slices := e.migSlices[m.Index]
+		if len(slices) > 0 {
+			m = reduceForMIGSlices(m, slices)
 		}
-		memoryFree := memoryTotal - memoryUsed
-
-		// Custom metrics
-		e.devFBAllocatedGauge.With(customDeviceLabels).Set(0) // Typically 0 when not allocated
-		e.devFBTotalGauge.With(customDeviceLabels).Set(memoryTotal)
-
-		// Standard device metrics
-		e.devAppMemClockGauge.With(deviceLabels).Set(1800) // MHz
-		e.devAppSMClockGauge.With(deviceLabels).Set(1700)  // MHz
-		e.devBAR1TotalGauge.With(deviceLabels).Set(memoryTotal)
-		e.devBAR1UsedGauge.With(deviceLabels).Set(memoryUsed)
-
-		// Clock throttle reasons (1=idle, 5=power limit)
-		throttleReason := 1.0
-		if rand.Float64() < 0.2 { // 20% chance of power limit
-			throttleReason = 5.0
+
+		e.setDeviceMetrics(m, m.UUID, "", "")
+
+		for _, s := range slices {
+			e.setDeviceMetrics(migSliceMetrics(m, s), mig.UUID(m.UUID, s), strconv.Itoa(s.GIIndex), s.Profile)
 		}
-		e.devClockThrottleReasons.With(deviceLabels).Set(throttleReason)
-
-		// Utilization metrics (typically 0% when idle)
-		e.devDecUtilGauge.With(deviceLabels).Set(0)
-		e.devEncUtilGauge.With(deviceLabels).Set(0)
-		e.devGPUUtilGauge.With(deviceLabels).Set(rand.Float64() * 10) // Low utilization
-
-		// Memory metrics
-		e.devFBFreeGauge.With(deviceLabels).Set(memoryFree)
-		e.devFBUsedGauge.With(deviceLabels).Set(memoryUsed)
-		e.devMemCopyUtilGauge.With(deviceLabels).Set(rand.Float64() * 5) // Low memory utilization
-
-		// Temperature metrics (realistic for idle GPUs)
-		gpuTemp := 30 + rand.Float64()*10         // 30-40Â°C
-		memTemp := gpuTemp + 2 + rand.Float64()*3 // Slightly higher
-		e.devGPUTempGauge.With(deviceLabels).Set(gpuTemp)
-		e.devMemoryTempGauge.With(deviceLabels).Set(memTemp)
-
-		// Clock frequencies
-		e.devMemClockGauge.With(deviceLabels).Set(1800)   // MHz
-		e.devSMClockGauge.With(deviceLabels).Set(200)     // MHz (idle frequency)
-		e.devVideoClockGauge.With(deviceLabels).Set(1000) // MHz
-
-		// Power usage (realistic for PPU cards)
-		powerUsage := 80 + rand.Float64()*15 // 80-95W
-		e.devPowerUsageGauge.With(deviceLabels).Set(powerUsage)
-
-		// Error counters (typically 0)
-		e.devRetiredDBE.With(deviceLabels).Add(0)
-		e.devRetiredPending.With(deviceLabels).Add(0)
-		e.devRetiredSBE.With(deviceLabels).Add(0)
-		e.devXIDErrorsGauge.With(deviceLabels).Set(0)
-
-		// Bandwidth counters (typically 0 when idle)
-		e.devNVLinkBandwidthTotal.With(deviceLabels).Add(0)
-
-		// Profiling metrics
-		e.profDRAMActiveGauge.With(deviceLabels).Set(rand.Float64() * 5) // Low activity
-		e.profNVLinkRXBytes.With(deviceLabels).Add(0)
-		e.profNVLinkTXBytes.With(deviceLabels).Add(0)
-		e.profPCIeRXBytes.With(deviceLabels).Set(0)
-		e.profPCIeTXBytes.With(deviceLabels).Set(0)
 	}
 
-	// Add some illegal process metrics for demo (only on GPU 0 and 14)
-	for _, gpuIdx := range []int{0, 14} {
-		gpuID := strconv.Itoa(gpuIdx)
-		deviceName := fmt.Sprintf("nvidia%d", gpuIdx)
-		uuid := e.generateUUID(gpuIdx)
+	// Illegal process metrics: processes running against the node's GPU
+	// sharing policy, not a hard-coded device pair.
+	procs, err := e.processSource.List()
+	if err != nil {
+		log.Printf("process source=%s list failed: %v", e.processSource.Name(), err)
+		return
+	}
+
+	for _, p := range procs {
+		if !e.processPolicy.IsIllegal(p) {
+			continue
+		}
+		if p.DeviceIndex >= len(uuids) {
+			continue
+		}
 
 		illegalLabels := prometheus.Labels{
-			"AllocateMode":  "none",
-			"ContainerName": "",
-			"NamespaceName": "",
+			"AllocateMode":  e.config.AllocateMode,
+			"ContainerName": p.ContainerName,
+			"NamespaceName": p.NamespaceName,
 			"NodeName":      e.config.NodeName,
 			"NodePoolId":    e.config.NodePoolId,
-			"PodName":       "",
+			"PodName":       p.PodName,
 			"PodSource":     e.config.PodSource,
-			"ProcessId":     "3003",
-			"ProcessName":   "python",
-			"ProcessType":   "C",
-			"UUID":          uuid,
-			"device":        deviceName,
-			"gpu":           gpuID,
+			"ProcessId":     strconv.Itoa(p.ProcessId),
+			"ProcessName":   p.ProcessName,
+			"ProcessType":   p.ProcessType,
+			"UUID":          uuids[p.DeviceIndex],
+			"device":        fmt.Sprintf("nvidia%d", p.DeviceIndex),
+			"gpu":           strconv.Itoa(p.DeviceIndex),
 			"modelName":     "PPU-ZW810E",
 		}
 
-		// Illegal process metrics
-		e.illegalProcessDecodeUtil.With(illegalLabels).Set(0)
-		e.illegalProcessEncodeUtil.With(illegalLabels).Set(0)
-
-		if gpuIdx == 14 {
-			e.illegalProcessMemCopyUtil.With(illegalLabels).Set(4)
-			e.illegalProcessMemUsed.With(illegalLabels).Set(4454)
-		} else {
-			e.illegalProcessMemCopyUtil.With(illegalLabels).Set(0)
-			e.illegalProcessMemUsed.With(illegalLabels).Set(544)
-		}
+		e.illegalProcessDecodeUtil.With(illegalLabels).Set(p.DecodeUtil)
+		e.illegalProcessEncodeUtil.With(illegalLabels).Set(p.EncodeUtil)
+		e.illegalProcessMemCopyUtil.With(illegalLabels).Set(p.MemCopyUtil)
+		e.illegalProcessMemUsed.With(illegalLabels).Set(p.MemUsed)
+		e.illegalProcessSMUtil.With(illegalLabels).Set(p.SMUtil)
+	}
+}
 
-		e.illegalProcessSMUtil.With(illegalLabels).Set(0)
+// allocateModeValue maps the human-readable --allocate-mode flag to the
+// numeric value DCGM_CUSTOM_ALLOCATE_MODE reports.
+func allocateModeValue(mode string) float64 {
+	switch mode {
+	case "Exclusive":
+		return 1
+	case "Share":
+		return 2
+	default:
+		return 0
 	}
 }
 
@@ -450,9 +537,26 @@ func main() {
 		port          = flag.Int("port", defaultPort, "Port to serve metrics")
 		gpuCount      = flag.Int("gpu-count", defaultGPUCount, "Number of GPUs to simulate")
 		driverVersion = flag.String("driver-version", "1.5.1-1d747a", "Driver version")
+		source        = flag.String("source", defaultSource, "Metrics source: nvml (real PPU-ZW810E telemetry, falls back to mock if NVML is unavailable) or mock (synthetic generator)")
+		allocateMode  = flag.String("allocate-mode", defaultAllocMode, "GPU allocate mode of the node: None, Exclusive, or Share. Share mode is what makes NVIDIA_VISIBLE_DEVICES=all an illegal process")
+		scenarioName  = flag.String("scenario", defaultScenario, "Mock workload profile: idle, training, inference, thermal-throttle, ecc-degrading, or nvlink-saturated. Ignored while the NVML source is in use; applies if --source=nvml falls back to mock")
+		scenarioFile  = flag.String("scenario-file", "", "YAML file with a `scenario: <name>` key, overriding --scenario")
+		pushEndpoint  = flag.String("push-endpoint", "", "If set, also push the registry here every 30s; /metrics is still served, there is no push-only mode")
+		pushProtocol  = flag.String("push-protocol", "pushgateway", "Push protocol when --push-endpoint is set: pushgateway, otlp-http, or otlp-grpc")
+		pushJob       = flag.String("push-job", defaultNodeName, "Pushgateway job name, used when --push-protocol=pushgateway")
+		migConfig     = flag.String("mig-config", "", "Per-GPU MIG slice layout, e.g. \"0:1g.10gb,1g.10gb;1:3g.40gb\" (source=mock only)")
 	)
 	flag.Parse()
 
+	resolvedScenario := *scenarioName
+	if *scenarioFile != "" {
+		name, err := scenario.LoadFile(*scenarioFile)
+		if err != nil {
+			log.Fatalf("reading scenario file %s: %v", *scenarioFile, err)
+		}
+		resolvedScenario = name
+	}
+
 	config := &Config{
 		NodeName:      *nodeName,
 		NodePoolId:    *nodePoolId,
@@ -460,6 +564,10 @@ func main() {
 		Port:          *port,
 		GPUCount:      *gpuCount,
 		DriverVersion: *driverVersion,
+		AllocateMode:  *allocateMode,
+		Source:        *source,
+		Scenario:      resolvedScenario,
+		MIGConfig:     *migConfig,
 	}
 
 	// Initialize random seed
@@ -470,11 +578,25 @@ func main() {
 	exporter := NewPPUExporter(config)
 	exporter.Register(registry)
 
-	// Update metrics periodically
+	var pusher push.Pusher
+	if *pushEndpoint != "" {
+		p, err := push.New(*pushProtocol, *pushEndpoint, *pushJob)
+		if err != nil {
+			log.Fatalf("configuring push: %v", err)
+		}
+		pusher = p
+	}
+
+	// Update (and, if configured, push) metrics periodically
 	ticker := time.NewTicker(30 * time.Second)
 	go func() {
 		for {
 			exporter.UpdateMetrics()
+			if pusher != nil {
+				if err := pusher.Push(registry); err != nil {
+					log.Printf("push via %s to %s failed: %v", pusher.Name(), *pushEndpoint, err)
+				}
+			}
 			<-ticker.C
 		}
 	}()
@@ -497,5 +619,12 @@ func main() {
 	log.Printf("Starting PPU exporter on port %d", config.Port)
 	log.Printf("Node name: %s", config.NodeName)
 	log.Printf("GPU count: %d", config.GPUCount)
+	log.Printf("Source: %s", exporter.source.Name())
+	if exporter.source.Name() == "mock" {
+		log.Printf("Scenario: %s", config.Scenario)
+	}
+	if pusher != nil {
+		log.Printf("Pushing via %s to %s every 30s", pusher.Name(), *pushEndpoint)
+	}
 	log.Fatal(http.ListenAndServe(fmt.Sprintf(":%d", config.Port), nil))
 }
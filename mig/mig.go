@@ -0,0 +1,96 @@
+// Package mig models MIG/slice-aware devices for --mig-config, so operators
+// can develop dashboards and alerts for sliced PPU deployments without real
+// MIG hardware. It mirrors how DCGM and cc-metric-collector expose MIG
+// instances: a GPU_I_ID/GPU_I_PROFILE label pair and a synthetic slice
+// UUID alongside the parent GPU's own series.
+package mig
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/Wangmin362/ppu-exporter-mock/collector"
+)
+
+// Slice is one configured GPU instance carved out of a parent GPU.
+type Slice struct {
+	ParentIndex int
+	GIIndex     int    // GPU instance ID
+	CIIndex     int    // compute instance ID, 0 for a plain 1:1 slice
+	Profile     string // e.g. "1g.10gb"
+	MemoryMiB   float64
+	// SMFraction is the slice's share of the parent's SM count, out of the
+	// 7-slot GPU instance grid DCGM reports profiles against.
+	SMFraction float64
+}
+
+var profilePattern = regexp.MustCompile(`^(\d+)g\.(\d+)gb$`)
+
+// ParseProfile decodes a MIG profile name like "1g.10gb" into its SM
+// fraction and memory size.
+func ParseProfile(profile string) (smFraction, memoryMiB float64, err error) {
+	match := profilePattern.FindStringSubmatch(profile)
+	if match == nil {
+		return 0, 0, fmt.Errorf("invalid MIG profile %q, want e.g. 1g.10gb", profile)
+	}
+	slots, _ := strconv.Atoi(match[1])
+	gib, _ := strconv.Atoi(match[2])
+	return float64(slots) / 7, float64(gib) * 1024, nil
+}
+
+// ParseConfig decodes a --mig-config value like
+// "0:1g.10gb,1g.10gb;1:3g.40gb" into the slices configured per parent GPU
+// index.
+func ParseConfig(config string) (map[int][]Slice, error) {
+	slices := make(map[int][]Slice)
+	if config == "" {
+		return slices, nil
+	}
+
+	for _, parentSpec := range strings.Split(config, ";") {
+		parentSpec = strings.TrimSpace(parentSpec)
+		if parentSpec == "" {
+			continue
+		}
+
+		parts := strings.SplitN(parentSpec, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid --mig-config segment %q, want <gpu>:<profile>[,<profile>...]", parentSpec)
+		}
+
+		parentIndex, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid parent GPU index %q: %w", parts[0], err)
+		}
+
+		var configuredMiB float64
+		for gi, profile := range strings.Split(parts[1], ",") {
+			smFraction, memoryMiB, err := ParseProfile(strings.TrimSpace(profile))
+			if err != nil {
+				return nil, err
+			}
+			configuredMiB += memoryMiB
+			if configuredMiB > collector.MemoryTotalMiB {
+				return nil, fmt.Errorf("invalid --mig-config for GPU %d: configured slices total %.0f MiB, exceeds the %.0f MiB parent", parentIndex, configuredMiB, collector.MemoryTotalMiB)
+			}
+			slices[parentIndex] = append(slices[parentIndex], Slice{
+				ParentIndex: parentIndex,
+				GIIndex:     gi,
+				CIIndex:     0,
+				Profile:     profile,
+				MemoryMiB:   memoryMiB,
+				SMFraction:  smFraction,
+			})
+		}
+	}
+
+	return slices, nil
+}
+
+// UUID returns the synthetic slice UUID convention DCGM and
+// cc-metric-collector both use: MIG-<parent-uuid>/<gi>/<ci>.
+func UUID(parentUUID string, s Slice) string {
+	return fmt.Sprintf("MIG-%s/%d/%d", parentUUID, s.GIIndex, s.CIIndex)
+}
@@ -0,0 +1,56 @@
+package mig
+
+import "testing"
+
+func TestParseProfile(t *testing.T) {
+	smFraction, memoryMiB, err := ParseProfile("1g.10gb")
+	if err != nil {
+		t.Fatalf("ParseProfile(1g.10gb): unexpected error: %v", err)
+	}
+	if got, want := smFraction, 1.0/7; got != want {
+		t.Errorf("smFraction = %v, want %v", got, want)
+	}
+	if got, want := memoryMiB, 10240.0; got != want {
+		t.Errorf("memoryMiB = %v, want %v", got, want)
+	}
+
+	if _, _, err := ParseProfile("not-a-profile"); err == nil {
+		t.Error("ParseProfile(not-a-profile): expected error, got nil")
+	}
+}
+
+func TestParseConfig(t *testing.T) {
+	slices, err := ParseConfig("0:1g.10gb,1g.10gb;1:3g.40gb")
+	if err != nil {
+		t.Fatalf("ParseConfig: unexpected error: %v", err)
+	}
+	if got, want := len(slices[0]), 2; got != want {
+		t.Fatalf("len(slices[0]) = %d, want %d", got, want)
+	}
+	if got, want := len(slices[1]), 1; got != want {
+		t.Fatalf("len(slices[1]) = %d, want %d", got, want)
+	}
+	if got, want := slices[1][0].MemoryMiB, 40960.0; got != want {
+		t.Errorf("slices[1][0].MemoryMiB = %v, want %v", got, want)
+	}
+
+	if _, err := ParseConfig("0-1g.10gb"); err == nil {
+		t.Error("ParseConfig(missing colon): expected error, got nil")
+	}
+	if _, err := ParseConfig("x:1g.10gb"); err == nil {
+		t.Error("ParseConfig(non-numeric index): expected error, got nil")
+	}
+	if _, err := ParseConfig("0:bogus"); err == nil {
+		t.Error("ParseConfig(bad profile): expected error, got nil")
+	}
+	if _, err := ParseConfig("0:7g.80gb,7g.80gb"); err == nil {
+		t.Error("ParseConfig(oversubscribed parent): expected error, got nil")
+	}
+}
+
+func TestUUID(t *testing.T) {
+	s := Slice{GIIndex: 2, CIIndex: 0}
+	if got, want := UUID("GPU-abc", s), "MIG-GPU-abc/2/0"; got != want {
+		t.Errorf("UUID = %q, want %q", got, want)
+	}
+}
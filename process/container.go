@@ -0,0 +1,73 @@
+package process
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// KubeResolver maps a container ID (as found in /proc/<pid>/cgroup) to the
+// pod metadata it belongs to. Wiring a real Kubernetes client is left to the
+// caller; NewNVMLSource works without one, it just leaves
+// ContainerName/NamespaceName/PodName empty.
+type KubeResolver interface {
+	Resolve(containerId string) (containerName, namespaceName, podName string, ok bool)
+}
+
+// containerId extracts the container ID from a process's cgroup path,
+// recognizing the cgroup v1/v2 layouts used by containerd, CRI-O, and
+// Docker (".../<runtime>/<64-hex-id>", ".../cri-containerd-<id>.scope",
+// ".../crio-<id>.scope", or ".../docker-<id>.scope").
+func containerId(pid int) (string, bool) {
+	f, err := os.Open(procPath(pid, "cgroup"))
+	if err != nil {
+		return "", false
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		if id, ok := containerIdFromCgroupLine(scanner.Text()); ok {
+			return id, true
+		}
+	}
+	return "", false
+}
+
+// containerIdFromCgroupLine extracts the container ID from a single line of
+// /proc/<pid>/cgroup, if that line's last path segment matches one of the
+// recognized runtime layouts.
+func containerIdFromCgroupLine(line string) (string, bool) {
+	idx := strings.LastIndex(line, "/")
+	if idx < 0 {
+		return "", false
+	}
+	segment := strings.TrimSuffix(line[idx+1:], ".scope")
+	segment = strings.TrimPrefix(segment, "cri-containerd-")
+	segment = strings.TrimPrefix(segment, "crio-")
+	segment = strings.TrimPrefix(segment, "docker-")
+	if len(segment) == 64 {
+		return segment, true
+	}
+	return "", false
+}
+
+// visibleDevicesAll reports whether the process's environment requested
+// every GPU via NVIDIA_VISIBLE_DEVICES=all.
+func visibleDevicesAll(pid int) bool {
+	data, err := os.ReadFile(procPath(pid, "environ"))
+	if err != nil {
+		return false
+	}
+	for _, kv := range strings.Split(string(data), "\x00") {
+		if kv == "NVIDIA_VISIBLE_DEVICES=all" {
+			return true
+		}
+	}
+	return false
+}
+
+func procPath(pid int, file string) string {
+	return "/proc/" + strconv.Itoa(pid) + "/" + file
+}
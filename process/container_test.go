@@ -0,0 +1,28 @@
+package process
+
+import "testing"
+
+func TestContainerIdFromCgroupLine(t *testing.T) {
+	const id = "abcdef0123456789abcdef0123456789abcdef0123456789abcdef0123456789"
+
+	tests := []struct {
+		name   string
+		line   string
+		wantId string
+		wantOk bool
+	}{
+		{"cri-containerd", "0::/kubepods/besteffort/pod123/cri-containerd-" + id + ".scope", id, true},
+		{"crio", "0::/kubepods/besteffort/pod123/crio-" + id + ".scope", id, true},
+		{"docker", "0::/docker/" + id, id, true},
+		{"no slash", "not-a-cgroup-line", "", false},
+		{"too short", "0::/kubepods/pod123/crio-deadbeef.scope", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotId, gotOk := containerIdFromCgroupLine(tt.line)
+			if gotId != tt.wantId || gotOk != tt.wantOk {
+				t.Errorf("containerIdFromCgroupLine(%q) = (%q, %v), want (%q, %v)", tt.line, gotId, gotOk, tt.wantId, tt.wantOk)
+			}
+		})
+	}
+}
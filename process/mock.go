@@ -0,0 +1,36 @@
+package process
+
+// MockSource reproduces the exporter's original two-process demo: a
+// "python" process on device 0 and 14, both requesting every GPU via
+// NVIDIA_VISIBLE_DEVICES=all. With --allocate-mode=Share the policy flags
+// both as illegal, so --source=mock produces illustrative
+// DCGM_CUSTOM_ILLEGAL_PROCESS_* series without real GPU processes to read;
+// under the default None mode no such series are emitted.
+type MockSource struct{}
+
+func NewMockSource() *MockSource { return &MockSource{} }
+
+func (s *MockSource) Name() string { return "mock" }
+
+func (s *MockSource) List() ([]Info, error) {
+	return []Info{
+		{
+			DeviceIndex:       0,
+			ProcessId:         3003,
+			ProcessName:       "python",
+			ProcessType:       "C",
+			MemCopyUtil:       0,
+			MemUsed:           544,
+			VisibleDevicesAll: true,
+		},
+		{
+			DeviceIndex:       14,
+			ProcessId:         3003,
+			ProcessName:       "python",
+			ProcessType:       "C",
+			MemCopyUtil:       4,
+			MemUsed:           4454,
+			VisibleDevicesAll: true,
+		},
+	}, nil
+}
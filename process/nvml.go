@@ -0,0 +1,101 @@
+package process
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/NVIDIA/go-nvml/pkg/nvml"
+)
+
+// NVMLSource enumerates real GPU processes via
+// nvmlDeviceGetComputeRunningProcesses/nvmlDeviceGetProcessUtilization, and
+// resolves each PID's container through /proc/<pid>/cgroup. Pod-level
+// metadata (NamespaceName/PodName) is only filled in when a KubeResolver is
+// configured.
+type NVMLSource struct {
+	Resolver KubeResolver
+
+	// lastSeenTimestamp is the newest ProcessUtilizationSample.TimeStamp
+	// seen per device index, passed back into GetProcessUtilization so it
+	// only returns samples since our last List call instead of every
+	// retained sample since boot.
+	lastSeenTimestamp map[int]uint64
+}
+
+func NewNVMLSource(resolver KubeResolver) *NVMLSource {
+	return &NVMLSource{Resolver: resolver, lastSeenTimestamp: make(map[int]uint64)}
+}
+
+func (s *NVMLSource) Name() string { return "nvml" }
+
+func (s *NVMLSource) List() ([]Info, error) {
+	count, ret := nvml.DeviceGetCount()
+	if ret != nvml.SUCCESS {
+		return nil, fmt.Errorf("nvml device count: %v", nvml.ErrorString(ret))
+	}
+
+	var infos []Info
+	for i := 0; i < count; i++ {
+		dev, ret := nvml.DeviceGetHandleByIndex(i)
+		if ret != nvml.SUCCESS {
+			return nil, fmt.Errorf("nvml device handle %d: %v", i, nvml.ErrorString(ret))
+		}
+
+		procs, ret := dev.GetComputeRunningProcesses()
+		if ret != nvml.SUCCESS {
+			return nil, fmt.Errorf("nvml running processes %d: %v", i, nvml.ErrorString(ret))
+		}
+
+		utils, _ := dev.GetProcessUtilization(s.lastSeenTimestamp[i])
+		utilByPid := make(map[int]nvml.ProcessUtilizationSample, len(utils))
+		for _, u := range utils {
+			if u.TimeStamp > s.lastSeenTimestamp[i] {
+				s.lastSeenTimestamp[i] = u.TimeStamp
+			}
+			utilByPid[int(u.Pid)] = u
+		}
+
+		for _, proc := range procs {
+			pid := int(proc.Pid)
+			info := Info{
+				DeviceIndex:       i,
+				ProcessId:         pid,
+				ProcessName:       processName(pid),
+				ProcessType:       "C",
+				MemUsed:           float64(proc.UsedGpuMemory) / (1 << 20),
+				VisibleDevicesAll: visibleDevicesAll(pid),
+			}
+
+			if u, ok := utilByPid[pid]; ok {
+				info.SMUtil = float64(u.SmUtil)
+				info.MemCopyUtil = float64(u.MemUtil)
+				info.EncodeUtil = float64(u.EncUtil)
+				info.DecodeUtil = float64(u.DecUtil)
+			}
+
+			if cid, ok := containerId(pid); ok {
+				info.ContainerName = cid
+				if s.Resolver != nil {
+					if name, ns, pod, ok := s.Resolver.Resolve(cid); ok {
+						info.ContainerName = name
+						info.NamespaceName = ns
+						info.PodName = pod
+					}
+				}
+			}
+
+			infos = append(infos, info)
+		}
+	}
+
+	return infos, nil
+}
+
+func processName(pid int) string {
+	name, err := os.ReadFile(procPath(pid, "comm"))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(name))
+}
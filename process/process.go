@@ -0,0 +1,53 @@
+// Package process enumerates GPU processes and decides which of them are
+// "illegal" under a node's GPU sharing policy, replacing what used to be a
+// hard-coded two-device demo in the exporter's UpdateMetrics.
+package process
+
+// Info describes one process found running on a device.
+type Info struct {
+	DeviceIndex int
+	ProcessId   int
+	ProcessName string
+	// ProcessType mirrors DCGM's convention: "C" for compute, "G" for
+	// graphics, "C+G" for both.
+	ProcessType string
+
+	ContainerName string
+	NamespaceName string
+	PodName       string
+
+	DecodeUtil  float64
+	EncodeUtil  float64
+	MemCopyUtil float64
+	SMUtil      float64
+	MemUsed     float64
+
+	// VisibleDevicesAll is true when the owning container requested GPUs
+	// with NVIDIA_VISIBLE_DEVICES=all, the signal the default Policy keys
+	// its classification on.
+	VisibleDevicesAll bool
+}
+
+// Source enumerates the GPU processes currently running on the node.
+type Source interface {
+	Name() string
+	List() ([]Info, error)
+}
+
+// Policy decides whether a process is "illegal": running against the node's
+// GPU sharing rules rather than a true NVML/driver error.
+type Policy interface {
+	IsIllegal(p Info) bool
+}
+
+// AllocateModePolicy flags a process illegal when the node is in Share
+// allocate mode (GPUs are meant to be time/space-sliced across pods) but the
+// owning container asked for every GPU via NVIDIA_VISIBLE_DEVICES=all,
+// defeating the sharing scheme.
+type AllocateModePolicy struct {
+	AllocateMode string
+}
+
+func (p AllocateModePolicy) IsIllegal(info Info) bool {
+	return p.AllocateMode == "Share" && info.VisibleDevicesAll
+}
@@ -0,0 +1,24 @@
+package process
+
+import "testing"
+
+func TestAllocateModePolicyIsIllegal(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   AllocateModePolicy
+		info     Info
+		wantBool bool
+	}{
+		{"share mode, visible-all", AllocateModePolicy{AllocateMode: "Share"}, Info{VisibleDevicesAll: true}, true},
+		{"share mode, not visible-all", AllocateModePolicy{AllocateMode: "Share"}, Info{VisibleDevicesAll: false}, false},
+		{"exclusive mode, visible-all", AllocateModePolicy{AllocateMode: "Exclusive"}, Info{VisibleDevicesAll: true}, false},
+		{"none mode, visible-all", AllocateModePolicy{AllocateMode: "None"}, Info{VisibleDevicesAll: true}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.policy.IsIllegal(tt.info); got != tt.wantBool {
+				t.Errorf("IsIllegal() = %v, want %v", got, tt.wantBool)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package process
+
+// New resolves a Source from the name of the metrics Source already chosen
+// by collector.New: "nvml" enumerates real processes, anything else gets the
+// illustrative mock pair. New does not probe NVML itself; passing the
+// resolved metrics source's Name() is what makes an NVML fallback to mock
+// carry over here.
+func New(source string) Source {
+	if source == "nvml" {
+		return NewNVMLSource(nil)
+	}
+	return NewMockSource()
+}
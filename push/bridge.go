@@ -0,0 +1,90 @@
+package push
+
+import (
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// pushStart is when this process started (the package's init time), the
+// OTLP-required StartTime for every counterPoints data point below: OTLP
+// defines a cumulative Sum relative to a start time, and most backends
+// reject or mis-compute rates for points that don't carry one.
+var pushStart = time.Now()
+
+// toResourceMetrics translates gathered Prometheus metric families into the
+// OTLP data model, preserving every label as a data point attribute. Each
+// GaugeVec becomes an OTLP Gauge; each CounterVec becomes a monotonic,
+// cumulative Sum — the same shapes cc-metric-collector and the DCGM
+// exporter already agree on for these field IDs.
+func toResourceMetrics(families []*dto.MetricFamily, res *resource.Resource) metricdata.ResourceMetrics {
+	now := time.Now()
+
+	scope := metricdata.ScopeMetrics{
+		Metrics: make([]metricdata.Metrics, 0, len(families)),
+	}
+
+	for _, family := range families {
+		switch family.GetType() {
+		case dto.MetricType_GAUGE:
+			scope.Metrics = append(scope.Metrics, metricdata.Metrics{
+				Name:        family.GetName(),
+				Description: family.GetHelp(),
+				Data: metricdata.Gauge[float64]{
+					DataPoints: gaugePoints(family, now),
+				},
+			})
+		case dto.MetricType_COUNTER:
+			scope.Metrics = append(scope.Metrics, metricdata.Metrics{
+				Name:        family.GetName(),
+				Description: family.GetHelp(),
+				Data: metricdata.Sum[float64]{
+					DataPoints:  counterPoints(family, pushStart, now),
+					Temporality: metricdata.CumulativeTemporality,
+					IsMonotonic: true,
+				},
+			})
+		}
+	}
+
+	return metricdata.ResourceMetrics{
+		Resource:     res,
+		ScopeMetrics: []metricdata.ScopeMetrics{scope},
+	}
+}
+
+func gaugePoints(family *dto.MetricFamily, ts time.Time) []metricdata.DataPoint[float64] {
+	points := make([]metricdata.DataPoint[float64], 0, len(family.Metric))
+	for _, m := range family.Metric {
+		points = append(points, metricdata.DataPoint[float64]{
+			Attributes: attributesFor(m),
+			Time:       ts,
+			Value:      m.GetGauge().GetValue(),
+		})
+	}
+	return points
+}
+
+func counterPoints(family *dto.MetricFamily, start, ts time.Time) []metricdata.DataPoint[float64] {
+	points := make([]metricdata.DataPoint[float64], 0, len(family.Metric))
+	for _, m := range family.Metric {
+		points = append(points, metricdata.DataPoint[float64]{
+			Attributes: attributesFor(m),
+			StartTime:  start,
+			Time:       ts,
+			Value:      m.GetCounter().GetValue(),
+		})
+	}
+	return points
+}
+
+func attributesFor(m *dto.Metric) attribute.Set {
+	kvs := make([]attribute.KeyValue, 0, len(m.Label))
+	for _, l := range m.Label {
+		kvs = append(kvs, attribute.String(l.GetName(), l.GetValue()))
+	}
+	return attribute.NewSet(kvs...)
+}
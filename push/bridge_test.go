@@ -0,0 +1,85 @@
+package push
+
+import (
+	"testing"
+
+	dto "github.com/prometheus/client_model/go"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+func strPtr(s string) *string     { return &s }
+func floatPtr(f float64) *float64 { return &f }
+
+func gaugeFamily(name, help string, value float64, labelName, labelValue string) *dto.MetricFamily {
+	typ := dto.MetricType_GAUGE
+	return &dto.MetricFamily{
+		Name: strPtr(name),
+		Help: strPtr(help),
+		Type: &typ,
+		Metric: []*dto.Metric{{
+			Label: []*dto.LabelPair{{Name: strPtr(labelName), Value: strPtr(labelValue)}},
+			Gauge: &dto.Gauge{Value: floatPtr(value)},
+		}},
+	}
+}
+
+func counterFamily(name, help string, value float64) *dto.MetricFamily {
+	typ := dto.MetricType_COUNTER
+	return &dto.MetricFamily{
+		Name:   strPtr(name),
+		Help:   strPtr(help),
+		Type:   &typ,
+		Metric: []*dto.Metric{{Counter: &dto.Counter{Value: floatPtr(value)}}},
+	}
+}
+
+func TestToResourceMetricsGauge(t *testing.T) {
+	rm := toResourceMetrics([]*dto.MetricFamily{
+		gaugeFamily("DCGM_FI_DEV_GPU_TEMP", "GPU temperature", 42, "gpu", "0"),
+	}, resource.NewSchemaless())
+
+	metrics := rm.ScopeMetrics[0].Metrics
+	if len(metrics) != 1 {
+		t.Fatalf("len(metrics) = %d, want 1", len(metrics))
+	}
+	if got, want := metrics[0].Name, "DCGM_FI_DEV_GPU_TEMP"; got != want {
+		t.Errorf("Name = %q, want %q", got, want)
+	}
+	if got, want := metrics[0].Description, "GPU temperature"; got != want {
+		t.Errorf("Description = %q, want %q", got, want)
+	}
+
+	gauge, ok := metrics[0].Data.(metricdata.Gauge[float64])
+	if !ok {
+		t.Fatalf("Data type = %T, want metricdata.Gauge[float64]", metrics[0].Data)
+	}
+	if got, want := gauge.DataPoints[0].Value, 42.0; got != want {
+		t.Errorf("Value = %v, want %v", got, want)
+	}
+}
+
+func TestToResourceMetricsCounterStartTime(t *testing.T) {
+	rm := toResourceMetrics([]*dto.MetricFamily{
+		counterFamily("DCGM_CUSTOM_XID_ERRORS_TOTAL", "XID error count", 7),
+	}, resource.NewSchemaless())
+
+	sum, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[float64])
+	if !ok {
+		t.Fatalf("Data type = %T, want metricdata.Sum[float64]", rm.ScopeMetrics[0].Metrics[0].Data)
+	}
+	if !sum.IsMonotonic {
+		t.Error("IsMonotonic = false, want true")
+	}
+	if sum.Temporality != metricdata.CumulativeTemporality {
+		t.Errorf("Temporality = %v, want CumulativeTemporality", sum.Temporality)
+	}
+
+	point := sum.DataPoints[0]
+	if point.StartTime.IsZero() {
+		t.Error("StartTime is zero, want a real process-start timestamp")
+	}
+	if point.Time.Before(point.StartTime) {
+		t.Errorf("Time (%v) is before StartTime (%v)", point.Time, point.StartTime)
+	}
+}
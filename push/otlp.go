@@ -0,0 +1,65 @@
+package push
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+const pushTimeout = 10 * time.Second
+
+// otlpExporter is the subset of metric.Exporter both otlpmetrichttp and
+// otlpmetricgrpc clients satisfy.
+type otlpExporter interface {
+	metric.Exporter
+}
+
+// otlpPusher gathers the registry and pushes it through whichever OTLP
+// exporter it was built with (HTTP or gRPC); the two only differ in how
+// they're constructed.
+type otlpPusher struct {
+	name     string
+	exporter otlpExporter
+	resource *resource.Resource
+}
+
+func NewOTLPHTTPPusher(endpoint string) (*otlpPusher, error) {
+	exp, err := otlpmetrichttp.New(context.Background(), otlpmetrichttp.WithEndpoint(endpoint))
+	if err != nil {
+		return nil, err
+	}
+	return &otlpPusher{name: "otlp-http", exporter: exp, resource: exporterResource()}, nil
+}
+
+func NewOTLPGRPCPusher(endpoint string) (*otlpPusher, error) {
+	exp, err := otlpmetricgrpc.New(context.Background(), otlpmetricgrpc.WithEndpoint(endpoint))
+	if err != nil {
+		return nil, err
+	}
+	return &otlpPusher{name: "otlp-grpc", exporter: exp, resource: exporterResource()}, nil
+}
+
+func (p *otlpPusher) Name() string { return p.name }
+
+func (p *otlpPusher) Push(registry *prometheus.Registry) error {
+	families, err := registry.Gather()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), pushTimeout)
+	defer cancel()
+
+	rm := toResourceMetrics(families, p.resource)
+	return p.exporter.Export(ctx, &rm)
+}
+
+func exporterResource() *resource.Resource {
+	return resource.NewSchemaless(semconv.ServiceName("ppu-exporter-mock"))
+}
@@ -0,0 +1,35 @@
+// Package push lets the exporter push its registry contents to an external
+// system on a schedule, for environments where Prometheus can't scrape the
+// pod directly (short-lived jobs, cross-cluster telemetry pipelines).
+package push
+
+import "github.com/prometheus/client_golang/prometheus"
+
+// Pusher sends the current contents of a registry to wherever it's
+// configured to go.
+type Pusher interface {
+	Name() string
+	Push(registry *prometheus.Registry) error
+}
+
+// New builds the Pusher named by protocol ("pushgateway", "otlp-http", or
+// "otlp-grpc"), pointed at endpoint. job names the Pushgateway job when
+// protocol is "pushgateway"; it's ignored otherwise.
+func New(protocol, endpoint, job string) (Pusher, error) {
+	switch protocol {
+	case "pushgateway":
+		return NewPushgatewayPusher(endpoint, job), nil
+	case "otlp-http":
+		return NewOTLPHTTPPusher(endpoint)
+	case "otlp-grpc":
+		return NewOTLPGRPCPusher(endpoint)
+	default:
+		return nil, errUnknownProtocol(protocol)
+	}
+}
+
+type errUnknownProtocol string
+
+func (e errUnknownProtocol) Error() string {
+	return "unknown push protocol " + string(e) + " (want pushgateway, otlp-http, or otlp-grpc)"
+}
@@ -0,0 +1,22 @@
+package push
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/push"
+)
+
+// PushgatewayPusher pushes the registry to a Prometheus Pushgateway.
+type PushgatewayPusher struct {
+	endpoint string
+	job      string
+}
+
+func NewPushgatewayPusher(endpoint, job string) *PushgatewayPusher {
+	return &PushgatewayPusher{endpoint: endpoint, job: job}
+}
+
+func (p *PushgatewayPusher) Name() string { return "pushgateway" }
+
+func (p *PushgatewayPusher) Push(registry *prometheus.Registry) error {
+	return push.New(p.endpoint, p.job).Gatherer(registry).Push()
+}
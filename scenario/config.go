@@ -0,0 +1,28 @@
+package scenario
+
+import (
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// fileConfig is the shape of the YAML file accepted by --scenario-file.
+type fileConfig struct {
+	Scenario string `yaml:"scenario"`
+}
+
+// LoadFile reads a YAML file of the form `scenario: training` and returns
+// the scenario name it names. It exists so a scenario can be pinned by a
+// config file mounted into the pod instead of a command-line flag.
+func LoadFile(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	var cfg fileConfig
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return "", err
+	}
+	return cfg.Scenario, nil
+}
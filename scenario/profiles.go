@@ -0,0 +1,212 @@
+package scenario
+
+import (
+	"math/rand"
+
+	"github.com/Wangmin362/ppu-exporter-mock/collector"
+)
+
+// base fills in the parts of a device's identity that don't vary by
+// scenario: UUID, model, and total memory.
+func base(index int) collector.DeviceMetrics {
+	return collector.DeviceMetrics{
+		Index:                index,
+		UUID:                 collector.DeviceUUID(index),
+		ModelName:            collector.ModelName,
+		MemoryTotal:          collector.MemoryTotalMiB,
+		ClockThrottleReasons: 1, // no throttling
+	}
+}
+
+func withMemory(m collector.DeviceMetrics, used float64) collector.DeviceMetrics {
+	m.MemoryUsed = used
+	m.MemoryFree = m.MemoryTotal - used
+	m.BAR1Total = m.MemoryTotal
+	m.BAR1Used = used
+	return m
+}
+
+// idleDriver is the original mock generator's behavior: a mostly-idle
+// fleet with the occasional device pinned to a higher memory footprint.
+type idleDriver struct{}
+
+func (idleDriver) Name() string { return "idle" }
+
+func (idleDriver) Generate(index int) collector.DeviceMetrics {
+	m := base(index)
+
+	memoryUsed := 18.0 + rand.Float64()*100
+	if index == 0 || index == 14 { // some GPUs have higher usage
+		memoryUsed = 500 + rand.Float64()*4000
+	}
+	m = withMemory(m, memoryUsed)
+
+	m.SMClock, m.MemClock, m.VideoClock = 200, 1800, 1000
+	m.AppSMClock, m.AppMemClock = 1700, 1800
+
+	gpuTemp := 30 + rand.Float64()*10
+	m.GPUTemp = gpuTemp
+	m.MemoryTemp = gpuTemp + 2 + rand.Float64()*3
+
+	m.PowerUsage = 80 + rand.Float64()*15
+	m.GPUUtil = rand.Float64() * 10
+	m.MemCopyUtil = rand.Float64() * 5
+	m.DRAMActive = rand.Float64() * 5
+
+	if rand.Float64() < 0.2 { // 20% chance of power limit
+		m.ClockThrottleReasons = 5
+	}
+
+	return m
+}
+
+// trainingDriver models a dense training job: high, steady GPU/DRAM
+// utilization, boosted clocks, and active NVLink traffic.
+type trainingDriver struct{}
+
+func (trainingDriver) Name() string { return "training" }
+
+func (trainingDriver) Generate(index int) collector.DeviceMetrics {
+	m := base(index)
+	m = withMemory(m, 55000+rand.Float64()*35000)
+
+	m.SMClock, m.MemClock, m.VideoClock = 1700, 1800, 1000
+	m.AppSMClock, m.AppMemClock = 1700, 1800
+
+	gpuTemp := 65 + rand.Float64()*15
+	m.GPUTemp = gpuTemp
+	m.MemoryTemp = gpuTemp + 3 + rand.Float64()*4
+
+	m.PowerUsage = 250 + rand.Float64()*40
+	m.GPUUtil = 85 + rand.Float64()*13
+	m.MemCopyUtil = 60 + rand.Float64()*20
+	m.DRAMActive = 70 + rand.Float64()*20
+
+	nvlinkBytes := 50_000_000 + rand.Float64()*150_000_000
+	m.NVLinkRXBytes = nvlinkBytes
+	m.NVLinkTXBytes = nvlinkBytes * (0.8 + rand.Float64()*0.2)
+	m.NVLinkBandwidthTotal = nvlinkBytes
+
+	return m
+}
+
+// inferenceDriver models a serving workload: moderate, bursty utilization
+// and modest power draw, well below training's sustained load.
+type inferenceDriver struct{}
+
+func (inferenceDriver) Name() string { return "inference" }
+
+func (inferenceDriver) Generate(index int) collector.DeviceMetrics {
+	m := base(index)
+	m = withMemory(m, 8000+rand.Float64()*12000)
+
+	m.SMClock, m.MemClock, m.VideoClock = 1400, 1800, 1000
+	m.AppSMClock, m.AppMemClock = 1400, 1800
+
+	gpuTemp := 50 + rand.Float64()*15
+	m.GPUTemp = gpuTemp
+	m.MemoryTemp = gpuTemp + 2 + rand.Float64()*3
+
+	m.PowerUsage = 150 + rand.Float64()*50
+	m.GPUUtil = 40 + rand.Float64()*30
+	m.MemCopyUtil = 20 + rand.Float64()*15
+	m.DRAMActive = 25 + rand.Float64()*20
+
+	return m
+}
+
+// thermalThrottleDriver models a device fighting its cooling limits: high
+// temperature, a fixed thermal throttle reason, and clocks depressed well
+// below their boost values.
+type thermalThrottleDriver struct{}
+
+func (thermalThrottleDriver) Name() string { return "thermal-throttle" }
+
+func (thermalThrottleDriver) Generate(index int) collector.DeviceMetrics {
+	m := base(index)
+	m = withMemory(m, 40000+rand.Float64()*30000)
+
+	m.SMClock, m.MemClock, m.VideoClock = 800, 1200, 800
+	m.AppSMClock, m.AppMemClock = 1700, 1800
+	m.ClockThrottleReasons = 128 // thermal slowdown
+
+	gpuTemp := 85 + rand.Float64()*10
+	m.GPUTemp = gpuTemp
+	m.MemoryTemp = gpuTemp + 3 + rand.Float64()*4
+
+	m.PowerUsage = 180 + rand.Float64()*40
+	m.GPUUtil = 50 + rand.Float64()*25
+	m.MemCopyUtil = 30 + rand.Float64()*20
+	m.DRAMActive = 35 + rand.Float64()*20
+
+	return m
+}
+
+// eccDegradingDriver models a device with failing memory: retired pages
+// accumulate tick over tick and the occasional XID error shows up.
+type eccDegradingDriver struct{}
+
+func (eccDegradingDriver) Name() string { return "ecc-degrading" }
+
+func (eccDegradingDriver) Generate(index int) collector.DeviceMetrics {
+	m := base(index)
+	m = withMemory(m, 18.0+rand.Float64()*100)
+
+	m.SMClock, m.MemClock, m.VideoClock = 200, 1800, 1000
+	m.AppSMClock, m.AppMemClock = 1700, 1800
+
+	gpuTemp := 35 + rand.Float64()*10
+	m.GPUTemp = gpuTemp
+	m.MemoryTemp = gpuTemp + 2 + rand.Float64()*3
+
+	m.PowerUsage = 80 + rand.Float64()*15
+	m.GPUUtil = rand.Float64() * 10
+	m.MemCopyUtil = rand.Float64() * 5
+	m.DRAMActive = rand.Float64() * 5
+
+	if rand.Float64() < 0.3 {
+		m.RetiredSBE = 1
+	}
+	if rand.Float64() < 0.05 {
+		m.RetiredDBE = 1
+		m.RetiredPending = 1
+	}
+	if rand.Float64() < 0.1 {
+		m.XIDErrors = 48 // double-bit ECC error
+	}
+
+	return m
+}
+
+// nvlinkSaturatedDriver models a multi-GPU collective saturating NVLink:
+// sustained high RX/TX throughput well above what training alone drives.
+type nvlinkSaturatedDriver struct{}
+
+func (nvlinkSaturatedDriver) Name() string { return "nvlink-saturated" }
+
+func (nvlinkSaturatedDriver) Generate(index int) collector.DeviceMetrics {
+	m := base(index)
+	m = withMemory(m, 45000+rand.Float64()*30000)
+
+	m.SMClock, m.MemClock, m.VideoClock = 1700, 1800, 1000
+	m.AppSMClock, m.AppMemClock = 1700, 1800
+
+	gpuTemp := 60 + rand.Float64()*15
+	m.GPUTemp = gpuTemp
+	m.MemoryTemp = gpuTemp + 3 + rand.Float64()*4
+
+	m.PowerUsage = 230 + rand.Float64()*40
+	m.GPUUtil = 70 + rand.Float64()*20
+	m.MemCopyUtil = 40 + rand.Float64()*20
+	m.DRAMActive = 50 + rand.Float64()*20
+
+	nvlinkBytes := 400_000_000 + rand.Float64()*200_000_000
+	m.NVLinkRXBytes = nvlinkBytes
+	m.NVLinkTXBytes = nvlinkBytes * (0.9 + rand.Float64()*0.1)
+	m.NVLinkBandwidthTotal = nvlinkBytes
+
+	m.PCIeRXBytes = 8_000_000_000 + rand.Float64()*4_000_000_000
+	m.PCIeTXBytes = m.PCIeRXBytes * 0.9
+
+	return m
+}
@@ -0,0 +1,41 @@
+// Package scenario drives the mock collector with reproducible,
+// testable workload shapes instead of the ad-hoc rand calls the generator
+// started out with. Each Driver keeps values coherent across metric
+// families — e.g. "training" raises GPU/memory utilization, power, and
+// temperature together rather than rolling each independently — so alert
+// rules written against one profile behave the way they would against a
+// real workload of that shape.
+package scenario
+
+import (
+	"log"
+
+	"github.com/Wangmin362/ppu-exporter-mock/collector"
+)
+
+// Driver generates one device's metrics for one collection tick under a
+// given workload profile.
+type Driver interface {
+	Name() string
+	Generate(index int) collector.DeviceMetrics
+}
+
+// Builtins are the profiles selectable via --scenario.
+var Builtins = map[string]Driver{
+	"idle":             idleDriver{},
+	"training":         trainingDriver{},
+	"inference":        inferenceDriver{},
+	"thermal-throttle": thermalThrottleDriver{},
+	"ecc-degrading":    eccDegradingDriver{},
+	"nvlink-saturated": nvlinkSaturatedDriver{},
+}
+
+// New resolves a --scenario name to a Driver, falling back to "idle" (and
+// logging why) for a name that isn't one of Builtins.
+func New(name string) Driver {
+	if d, ok := Builtins[name]; ok {
+		return d
+	}
+	log.Printf("unknown scenario %q, falling back to idle", name)
+	return Builtins["idle"]
+}
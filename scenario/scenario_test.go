@@ -0,0 +1,44 @@
+package scenario
+
+import "testing"
+
+func TestNewResolvesBuiltins(t *testing.T) {
+	for name, want := range Builtins {
+		if got := New(name); got.Name() != want.Name() {
+			t.Errorf("New(%q).Name() = %q, want %q", name, got.Name(), want.Name())
+		}
+	}
+}
+
+func TestNewFallsBackToIdleForUnknownName(t *testing.T) {
+	got := New("not-a-real-scenario")
+	if got.Name() != "idle" {
+		t.Errorf("New(unknown).Name() = %q, want %q", got.Name(), "idle")
+	}
+}
+
+func TestBuiltinsKeyMatchesDriverName(t *testing.T) {
+	for key, d := range Builtins {
+		if d.Name() != key {
+			t.Errorf("Builtins[%q].Name() = %q, want %q", key, d.Name(), key)
+		}
+	}
+}
+
+func TestGenerateProducesConsistentDeviceIdentity(t *testing.T) {
+	for name, d := range Builtins {
+		m := d.Generate(3)
+		if m.Index != 3 {
+			t.Errorf("%s: Index = %d, want 3", name, m.Index)
+		}
+		if m.MemoryTotal <= 0 {
+			t.Errorf("%s: MemoryTotal = %v, want > 0", name, m.MemoryTotal)
+		}
+		if m.MemoryUsed > m.MemoryTotal {
+			t.Errorf("%s: MemoryUsed (%v) > MemoryTotal (%v)", name, m.MemoryUsed, m.MemoryTotal)
+		}
+		if m.UUID == "" {
+			t.Errorf("%s: UUID is empty", name)
+		}
+	}
+}